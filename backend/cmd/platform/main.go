@@ -0,0 +1,124 @@
+// Command platform runs the k8s-user-platform HTTP API.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/joho/godotenv"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/redis/go-redis/v9"
+
+	"github.com/AMJIETIK/k8s-user-platform/backend/pkg/auth"
+	"github.com/AMJIETIK/k8s-user-platform/backend/pkg/config"
+	"github.com/AMJIETIK/k8s-user-platform/backend/pkg/db"
+	"github.com/AMJIETIK/k8s-user-platform/backend/pkg/handlers"
+	"github.com/AMJIETIK/k8s-user-platform/backend/pkg/logging"
+	"github.com/AMJIETIK/k8s-user-platform/backend/pkg/metrics"
+	"github.com/AMJIETIK/k8s-user-platform/backend/pkg/migrations"
+	"github.com/AMJIETIK/k8s-user-platform/backend/pkg/ratelimit"
+	"github.com/AMJIETIK/k8s-user-platform/backend/pkg/server"
+)
+
+func main() {
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		runMigrateCommand(os.Args[2:])
+		return
+	}
+
+	migrationsOnly := flag.Bool("migrations-only", false, "apply pending migrations then exit, without starting the HTTP server")
+	flag.Parse()
+
+	logger := logging.New()
+	logger.Info("starting application")
+
+	cfg, err := config.Load()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err := migrations.Up(cfg.DatabaseURL); err != nil {
+		log.Fatal(err)
+	}
+	logger.Info("migrations applied")
+
+	if *migrationsOnly {
+		return
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	pool, err := db.NewPool(ctx, cfg.DatabaseURL)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer pool.Close()
+	prometheus.MustRegister(metrics.NewDBPoolCollector(pool))
+	logger.Info("successfully connected to database")
+
+	store := db.NewPostgresUserStore(pool)
+	tokenStore := db.NewPostgresTokenStore(pool)
+	authSvc := auth.NewService(store, tokenStore, cfg.JWTSecret)
+
+	h := handlers.NewServer(store)
+	a := handlers.NewAuthServer(authSvc)
+	health := handlers.NewHealthServer(pool)
+
+	limiterCfg := ratelimit.Config{RPS: cfg.RateLimitRPS, Burst: cfg.RateLimitBurst}
+	var limiter ratelimit.Limiter
+	if cfg.RedisAddr != "" {
+		limiter = ratelimit.NewRedisLimiter(redis.NewClient(&redis.Options{Addr: cfg.RedisAddr}), limiterCfg)
+	} else {
+		limiter = ratelimit.NewMemoryLimiter(limiterCfg)
+	}
+
+	srv := server.New(":"+cfg.Port, h, a, authSvc, health, logger, limiter, cfg.TrustedProxyCIDRs)
+
+	if err := srv.Run(ctx); err != nil {
+		log.Fatalf("Server error: %v", err)
+	}
+}
+
+// runMigrateCommand implements `platform migrate up|down|version`, reading
+// only DATABASE_URL so it can run standalone in a Kubernetes init container.
+func runMigrateCommand(args []string) {
+	if len(args) != 1 {
+		log.Fatal("usage: platform migrate up|down|version")
+	}
+
+	// Load .env the same way config.Load() does, so local dev setups that
+	// rely on it work for `platform migrate` too.
+	if os.Getenv("ENV") != "prod" {
+		_ = godotenv.Load()
+	}
+
+	databaseURL := os.Getenv("DATABASE_URL")
+	if databaseURL == "" {
+		log.Fatal("DATABASE_URL is not set")
+	}
+
+	switch args[0] {
+	case "up":
+		if err := migrations.Up(databaseURL); err != nil {
+			log.Fatal(err)
+		}
+	case "down":
+		if err := migrations.Down(databaseURL); err != nil {
+			log.Fatal(err)
+		}
+	case "version":
+		version, dirty, err := migrations.Version(databaseURL)
+		if err != nil {
+			log.Fatal(err)
+		}
+		fmt.Printf("version %d (dirty=%t)\n", version, dirty)
+	default:
+		log.Fatalf("unknown migrate subcommand %q", args[0])
+	}
+}
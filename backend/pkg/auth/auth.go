@@ -0,0 +1,216 @@
+// Package auth implements JWT issuing/validation, password hashing, and the
+// register/login/refresh/logout flows.
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/AMJIETIK/k8s-user-platform/backend/pkg/db"
+)
+
+const (
+	// RoleUser is the default role assigned at registration.
+	RoleUser = "user"
+	// RoleAdmin grants access to other users' resources.
+	RoleAdmin = "admin"
+
+	accessTokenTTL  = 15 * time.Minute
+	refreshTokenTTL = 7 * 24 * time.Hour
+
+	// TokenTypeAccess marks a token as a short-lived bearer credential for
+	// protected routes.
+	TokenTypeAccess = "access"
+	// TokenTypeRefresh marks a token as valid only for exchange at
+	// /auth/refresh.
+	TokenTypeRefresh = "refresh"
+)
+
+// ErrInvalidCredentials is returned when a login fails authentication.
+var ErrInvalidCredentials = errors.New("invalid credentials")
+
+// errWrongTokenType is returned when a token is presented for a purpose
+// other than the one it was issued for.
+var errWrongTokenType = errors.New("wrong token type")
+
+// Claims are the JWT claims issued by this service.
+type Claims struct {
+	Role string `json:"role"`
+	// Type distinguishes access tokens from refresh tokens so one can't be
+	// used in place of the other.
+	Type string `json:"typ"`
+	jwt.RegisteredClaims
+}
+
+// Tokens is an access/refresh token pair returned by login and refresh.
+type Tokens struct {
+	AccessToken  string `json:"access_token"`
+	RefreshToken string `json:"refresh_token"`
+}
+
+// Service implements the authentication subsystem: registration, login,
+// token refresh, logout, and token validation.
+type Service struct {
+	Users  db.UserStore
+	Tokens db.TokenStore
+	secret []byte
+}
+
+// NewService returns an auth Service signing and verifying tokens with
+// secret.
+func NewService(users db.UserStore, tokens db.TokenStore, secret string) *Service {
+	return &Service{Users: users, Tokens: tokens, secret: []byte(secret)}
+}
+
+// Register creates a new user with a bcrypt-hashed password and the default
+// role.
+func (s *Service) Register(ctx context.Context, name, email, password string) (db.User, error) {
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return db.User{}, fmt.Errorf("hashing password: %w", err)
+	}
+
+	return s.Users.CreateUserWithPassword(ctx, db.User{
+		Name:         name,
+		Email:        email,
+		PasswordHash: string(hash),
+		Role:         RoleUser,
+	})
+}
+
+// Login verifies email/password and issues a new token pair.
+func (s *Service) Login(ctx context.Context, email, password string) (Tokens, error) {
+	user, err := s.Users.GetUserByEmail(ctx, email)
+	if err != nil {
+		if errors.Is(err, db.ErrNotFound) {
+			return Tokens{}, ErrInvalidCredentials
+		}
+		return Tokens{}, err
+	}
+
+	if err := bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(password)); err != nil {
+		return Tokens{}, ErrInvalidCredentials
+	}
+
+	return s.issueTokens(user)
+}
+
+// Refresh validates a refresh token and issues a new token pair, revoking
+// the old refresh token.
+func (s *Service) Refresh(ctx context.Context, refreshToken string) (Tokens, error) {
+	claims, err := s.Parse(ctx, refreshToken, TokenTypeRefresh)
+	if err != nil {
+		return Tokens{}, err
+	}
+
+	if err := s.revoke(ctx, claims); err != nil {
+		return Tokens{}, err
+	}
+
+	user, err := s.Users.GetUser(ctx, userIDFromSubject(claims.Subject))
+	if err != nil {
+		return Tokens{}, err
+	}
+
+	return s.issueTokens(user)
+}
+
+// Logout revokes the given access token and, if provided, the given refresh
+// token, so neither can be used again.
+func (s *Service) Logout(ctx context.Context, accessToken, refreshToken string) error {
+	claims, err := s.Parse(ctx, accessToken, TokenTypeAccess)
+	if err != nil {
+		return err
+	}
+	if err := s.revoke(ctx, claims); err != nil {
+		return err
+	}
+
+	if refreshToken == "" {
+		return nil
+	}
+
+	refreshClaims, err := s.Parse(ctx, refreshToken, TokenTypeRefresh)
+	if err != nil {
+		return err
+	}
+	return s.revoke(ctx, refreshClaims)
+}
+
+// Parse validates a token's signature, expiry, type, and revocation status,
+// and returns its claims. wantType must match the token's Type claim.
+func (s *Service) Parse(ctx context.Context, token, wantType string) (*Claims, error) {
+	claims := &Claims{}
+	parsed, err := jwt.ParseWithClaims(token, claims, func(t *jwt.Token) (interface{}, error) {
+		if _, ok := t.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("unexpected signing method: %v", t.Header["alg"])
+		}
+		return s.secret, nil
+	})
+	if err != nil || !parsed.Valid {
+		return nil, fmt.Errorf("parsing token: %w", err)
+	}
+
+	if claims.Type != wantType {
+		return nil, errWrongTokenType
+	}
+
+	revoked, err := s.Tokens.IsRevoked(ctx, claims.ID)
+	if err != nil {
+		return nil, err
+	}
+	if revoked {
+		return nil, errors.New("token has been revoked")
+	}
+
+	return claims, nil
+}
+
+func (s *Service) issueTokens(user db.User) (Tokens, error) {
+	access, err := s.sign(user, TokenTypeAccess, accessTokenTTL)
+	if err != nil {
+		return Tokens{}, err
+	}
+	refresh, err := s.sign(user, TokenTypeRefresh, refreshTokenTTL)
+	if err != nil {
+		return Tokens{}, err
+	}
+	return Tokens{AccessToken: access, RefreshToken: refresh}, nil
+}
+
+func (s *Service) sign(user db.User, tokenType string, ttl time.Duration) (string, error) {
+	now := time.Now()
+	claims := &Claims{
+		Role: user.Role,
+		Type: tokenType,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        uuid.NewString(),
+			Subject:   fmt.Sprintf("%d", user.ID),
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(ttl)),
+		},
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	signed, err := token.SignedString(s.secret)
+	if err != nil {
+		return "", fmt.Errorf("signing token: %w", err)
+	}
+	return signed, nil
+}
+
+func (s *Service) revoke(ctx context.Context, claims *Claims) error {
+	return s.Tokens.Revoke(ctx, claims.ID, claims.ExpiresAt.Time)
+}
+
+func userIDFromSubject(sub string) int64 {
+	var id int64
+	_, _ = fmt.Sscanf(sub, "%d", &id)
+	return id
+}
@@ -0,0 +1,234 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/AMJIETIK/k8s-user-platform/backend/pkg/db"
+)
+
+// mockUserStore is an in-memory db.UserStore for auth tests.
+type mockUserStore struct {
+	byID    map[int64]db.User
+	byEmail map[string]db.User
+	nextID  int64
+}
+
+func newMockUserStore() *mockUserStore {
+	return &mockUserStore{byID: make(map[int64]db.User), byEmail: make(map[string]db.User), nextID: 1}
+}
+
+func (m *mockUserStore) CreateUser(context.Context, db.User) (db.User, error) {
+	return db.User{}, errors.New("not implemented")
+}
+
+func (m *mockUserStore) ListUsers(context.Context, db.ListUsersParams) (db.ListUsersResult, error) {
+	return db.ListUsersResult{}, errors.New("not implemented")
+}
+
+func (m *mockUserStore) GetUser(_ context.Context, id int64) (db.User, error) {
+	u, ok := m.byID[id]
+	if !ok {
+		return db.User{}, db.ErrNotFound
+	}
+	return u, nil
+}
+
+func (m *mockUserStore) UpdateUser(context.Context, int64, db.User) (db.User, error) {
+	return db.User{}, errors.New("not implemented")
+}
+
+func (m *mockUserStore) DeleteUser(context.Context, int64) error {
+	return errors.New("not implemented")
+}
+
+func (m *mockUserStore) CreateUserWithPassword(_ context.Context, u db.User) (db.User, error) {
+	u.ID = m.nextID
+	m.nextID++
+	m.byID[u.ID] = u
+	m.byEmail[u.Email] = u
+	return u, nil
+}
+
+func (m *mockUserStore) GetUserByEmail(_ context.Context, email string) (db.User, error) {
+	u, ok := m.byEmail[email]
+	if !ok {
+		return db.User{}, db.ErrNotFound
+	}
+	return u, nil
+}
+
+// mockTokenStore is an in-memory db.TokenStore for auth tests.
+type mockTokenStore struct {
+	revoked map[string]bool
+}
+
+func newMockTokenStore() *mockTokenStore {
+	return &mockTokenStore{revoked: make(map[string]bool)}
+}
+
+func (m *mockTokenStore) Revoke(_ context.Context, jti string, _ time.Time) error {
+	m.revoked[jti] = true
+	return nil
+}
+
+func (m *mockTokenStore) IsRevoked(_ context.Context, jti string) (bool, error) {
+	return m.revoked[jti], nil
+}
+
+func newTestService() (*Service, *mockUserStore, *mockTokenStore) {
+	users := newMockUserStore()
+	tokens := newMockTokenStore()
+	return NewService(users, tokens, "test-secret"), users, tokens
+}
+
+func TestService_LoginIssuesDistinctAccessAndRefreshTokens(t *testing.T) {
+	svc, users, _ := newTestService()
+	ctx := context.Background()
+	if _, err := svc.Register(ctx, "Ada", "ada@example.com", "password123"); err != nil {
+		t.Fatalf("Register: %v", err)
+	}
+	_ = users
+
+	tokens, err := svc.Login(ctx, "ada@example.com", "password123")
+	if err != nil {
+		t.Fatalf("Login: %v", err)
+	}
+
+	access, err := svc.Parse(ctx, tokens.AccessToken, TokenTypeAccess)
+	if err != nil {
+		t.Fatalf("parsing access token: %v", err)
+	}
+	if access.Type != TokenTypeAccess {
+		t.Fatalf("access token type = %q, want %q", access.Type, TokenTypeAccess)
+	}
+
+	refresh, err := svc.Parse(ctx, tokens.RefreshToken, TokenTypeRefresh)
+	if err != nil {
+		t.Fatalf("parsing refresh token: %v", err)
+	}
+	if refresh.Type != TokenTypeRefresh {
+		t.Fatalf("refresh token type = %q, want %q", refresh.Type, TokenTypeRefresh)
+	}
+}
+
+func TestService_AccessTokenRejectedAsRefreshToken(t *testing.T) {
+	svc, _, _ := newTestService()
+	ctx := context.Background()
+	if _, err := svc.Register(ctx, "Ada", "ada@example.com", "password123"); err != nil {
+		t.Fatalf("Register: %v", err)
+	}
+	tokens, err := svc.Login(ctx, "ada@example.com", "password123")
+	if err != nil {
+		t.Fatalf("Login: %v", err)
+	}
+
+	if _, err := svc.Parse(ctx, tokens.AccessToken, TokenTypeRefresh); err == nil {
+		t.Fatal("expected access token to be rejected as a refresh token")
+	}
+	if _, err := svc.Refresh(ctx, tokens.AccessToken); err == nil {
+		t.Fatal("expected Refresh to reject an access token")
+	}
+}
+
+func TestService_RefreshTokenRejectedAsAccessToken(t *testing.T) {
+	svc, _, _ := newTestService()
+	ctx := context.Background()
+	if _, err := svc.Register(ctx, "Ada", "ada@example.com", "password123"); err != nil {
+		t.Fatalf("Register: %v", err)
+	}
+	tokens, err := svc.Login(ctx, "ada@example.com", "password123")
+	if err != nil {
+		t.Fatalf("Login: %v", err)
+	}
+
+	if _, err := svc.Parse(ctx, tokens.RefreshToken, TokenTypeAccess); err == nil {
+		t.Fatal("expected refresh token to be rejected as an access token")
+	}
+}
+
+func TestService_LogoutRevokesBothTokens(t *testing.T) {
+	svc, _, _ := newTestService()
+	ctx := context.Background()
+	if _, err := svc.Register(ctx, "Ada", "ada@example.com", "password123"); err != nil {
+		t.Fatalf("Register: %v", err)
+	}
+	tokens, err := svc.Login(ctx, "ada@example.com", "password123")
+	if err != nil {
+		t.Fatalf("Login: %v", err)
+	}
+
+	if err := svc.Logout(ctx, tokens.AccessToken, tokens.RefreshToken); err != nil {
+		t.Fatalf("Logout: %v", err)
+	}
+
+	if _, err := svc.Parse(ctx, tokens.AccessToken, TokenTypeAccess); err == nil {
+		t.Fatal("expected access token to be revoked after logout")
+	}
+	if _, err := svc.Parse(ctx, tokens.RefreshToken, TokenTypeRefresh); err == nil {
+		t.Fatal("expected refresh token to be revoked after logout")
+	}
+}
+
+func TestService_RefreshRevokesOldRefreshToken(t *testing.T) {
+	svc, _, _ := newTestService()
+	ctx := context.Background()
+	if _, err := svc.Register(ctx, "Ada", "ada@example.com", "password123"); err != nil {
+		t.Fatalf("Register: %v", err)
+	}
+	tokens, err := svc.Login(ctx, "ada@example.com", "password123")
+	if err != nil {
+		t.Fatalf("Login: %v", err)
+	}
+
+	if _, err := svc.Refresh(ctx, tokens.RefreshToken); err != nil {
+		t.Fatalf("Refresh: %v", err)
+	}
+
+	if _, err := svc.Parse(ctx, tokens.RefreshToken, TokenTypeRefresh); err == nil {
+		t.Fatal("expected old refresh token to be revoked")
+	}
+}
+
+func TestService_RefreshReflectsCurrentDBRole(t *testing.T) {
+	svc, users, _ := newTestService()
+	ctx := context.Background()
+	created, err := svc.Register(ctx, "Ada", "ada@example.com", "password123")
+	if err != nil {
+		t.Fatalf("Register: %v", err)
+	}
+
+	// Promote to admin before logging in, so the refresh token's stale role
+	// claim starts out as admin.
+	promoted := users.byID[created.ID]
+	promoted.Role = RoleAdmin
+	users.byID[created.ID] = promoted
+	users.byEmail[promoted.Email] = promoted
+
+	tokens, err := svc.Login(ctx, "ada@example.com", "password123")
+	if err != nil {
+		t.Fatalf("Login: %v", err)
+	}
+
+	// Simulate an operator revoking admin rights after the refresh token was
+	// issued, e.g. demoting the user in the database directly.
+	demoted := users.byID[created.ID]
+	demoted.Role = RoleUser
+	users.byID[created.ID] = demoted
+	users.byEmail[demoted.Email] = demoted
+
+	refreshed, err := svc.Refresh(ctx, tokens.RefreshToken)
+	if err != nil {
+		t.Fatalf("Refresh: %v", err)
+	}
+
+	access, err := svc.Parse(ctx, refreshed.AccessToken, TokenTypeAccess)
+	if err != nil {
+		t.Fatalf("parsing access token: %v", err)
+	}
+	if access.Role != RoleUser {
+		t.Fatalf("access token role = %q, want %q (DB role should win over stale refresh token claim)", access.Role, RoleUser)
+	}
+}
@@ -0,0 +1,119 @@
+// Package config loads and validates application configuration from the
+// environment.
+package config
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/joho/godotenv"
+)
+
+const (
+	defaultRateLimitRPS   = 10
+	defaultRateLimitBurst = 20
+)
+
+// Config holds the runtime configuration for the platform service.
+type Config struct {
+	DatabaseURL string
+	Port        string
+	Env         string
+	JWTSecret   string
+
+	RateLimitRPS   float64
+	RateLimitBurst int
+	// RedisAddr, if set, backs the rate limiter with Redis instead of an
+	// in-memory store, for multi-replica deployments.
+	RedisAddr string
+	// TrustedProxyCIDRs are the networks (e.g. the ingress/LB subnet) whose
+	// X-Forwarded-For header the per-IP rate limiter is allowed to trust.
+	// Requests from outside these networks are keyed on RemoteAddr only, so
+	// an untrusted client can't spoof its way into someone else's bucket.
+	TrustedProxyCIDRs []*net.IPNet
+}
+
+// Load reads configuration from the environment, loading a .env file first
+// when not running in production. It returns an error if a required value
+// is missing.
+func Load() (*Config, error) {
+	env := os.Getenv("ENV")
+	if env != "prod" {
+		_ = godotenv.Load()
+	}
+
+	databaseURL := os.Getenv("DATABASE_URL")
+	if databaseURL == "" {
+		return nil, fmt.Errorf("DATABASE_URL is not set")
+	}
+
+	port := os.Getenv("APP_PORT")
+	if port == "" {
+		port = "8080"
+	}
+
+	jwtSecret := os.Getenv("JWT_SECRET")
+	if jwtSecret == "" {
+		return nil, fmt.Errorf("JWT_SECRET is not set")
+	}
+
+	rateLimitRPS := float64(defaultRateLimitRPS)
+	if raw := os.Getenv("RATE_LIMIT_RPS"); raw != "" {
+		parsed, err := strconv.ParseFloat(raw, 64)
+		if err != nil {
+			return nil, fmt.Errorf("RATE_LIMIT_RPS is invalid: %w", err)
+		}
+		rateLimitRPS = parsed
+	}
+
+	rateLimitBurst := defaultRateLimitBurst
+	if raw := os.Getenv("RATE_LIMIT_BURST"); raw != "" {
+		parsed, err := strconv.Atoi(raw)
+		if err != nil {
+			return nil, fmt.Errorf("RATE_LIMIT_BURST is invalid: %w", err)
+		}
+		rateLimitBurst = parsed
+	}
+
+	trustedProxyCIDRs, err := parseTrustedProxyCIDRs(os.Getenv("TRUSTED_PROXY_CIDRS"))
+	if err != nil {
+		return nil, err
+	}
+
+	return &Config{
+		DatabaseURL:       databaseURL,
+		Port:              port,
+		Env:               env,
+		JWTSecret:         jwtSecret,
+		RateLimitRPS:      rateLimitRPS,
+		RateLimitBurst:    rateLimitBurst,
+		RedisAddr:         os.Getenv("REDIS_ADDR"),
+		TrustedProxyCIDRs: trustedProxyCIDRs,
+	}, nil
+}
+
+// parseTrustedProxyCIDRs parses a comma-separated list of CIDRs, e.g.
+// "10.0.0.0/8,172.16.0.0/12" for the in-cluster pod/service ranges in front
+// of a typical k8s ingress. An empty string yields no trusted proxies.
+func parseTrustedProxyCIDRs(raw string) ([]*net.IPNet, error) {
+	if raw == "" {
+		return nil, nil
+	}
+
+	var cidrs []*net.IPNet
+	for _, part := range strings.Split(raw, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		_, network, err := net.ParseCIDR(part)
+		if err != nil {
+			return nil, fmt.Errorf("TRUSTED_PROXY_CIDRS: invalid CIDR %q: %w", part, err)
+		}
+		cidrs = append(cidrs, network)
+	}
+	return cidrs, nil
+}
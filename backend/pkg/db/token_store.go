@@ -0,0 +1,48 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// TokenStore tracks revoked JWTs so logout can invalidate a token before its
+// natural expiry.
+type TokenStore interface {
+	Revoke(ctx context.Context, jti string, expiresAt time.Time) error
+	IsRevoked(ctx context.Context, jti string) (bool, error)
+}
+
+// PostgresTokenStore is a TokenStore backed by a pgx connection pool.
+type PostgresTokenStore struct {
+	pool *pgxpool.Pool
+}
+
+// NewPostgresTokenStore returns a TokenStore backed by pool.
+func NewPostgresTokenStore(pool *pgxpool.Pool) *PostgresTokenStore {
+	return &PostgresTokenStore{pool: pool}
+}
+
+func (s *PostgresTokenStore) Revoke(ctx context.Context, jti string, expiresAt time.Time) error {
+	_, err := s.pool.Exec(ctx,
+		"INSERT INTO token_revocations (jti, expires_at) VALUES ($1, $2) ON CONFLICT (jti) DO NOTHING",
+		jti, expiresAt,
+	)
+	if err != nil {
+		return fmt.Errorf("revoking token: %w", err)
+	}
+	return nil
+}
+
+func (s *PostgresTokenStore) IsRevoked(ctx context.Context, jti string) (bool, error) {
+	var exists bool
+	err := s.pool.QueryRow(ctx,
+		"SELECT EXISTS(SELECT 1 FROM token_revocations WHERE jti = $1)", jti,
+	).Scan(&exists)
+	if err != nil {
+		return false, fmt.Errorf("checking token revocation: %w", err)
+	}
+	return exists, nil
+}
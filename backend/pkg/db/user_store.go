@@ -0,0 +1,201 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// ErrNotFound is returned when a requested user does not exist.
+var ErrNotFound = errors.New("user not found")
+
+// User is a platform user record.
+type User struct {
+	ID           int64  `json:"id"`
+	Name         string `json:"name" validate:"required,min=1,max=100,nocontrol"`
+	Email        string `json:"email" validate:"required,email"`
+	Role         string `json:"role,omitempty"`
+	PasswordHash string `json:"-"`
+}
+
+// ListUsersParams controls pagination, sorting, and search for ListUsers.
+type ListUsersParams struct {
+	Limit  int
+	Offset int
+	Sort   string // "name", "email", or "created_at"
+	Order  string // "asc" or "desc"
+	Search string // matched against name/email via ILIKE
+}
+
+// ListUsersResult is a page of users plus the total number of users
+// matching the search filter.
+type ListUsersResult struct {
+	Users []User
+	Total int
+}
+
+// UserStore is the persistence interface handlers depend on, allowing the
+// Postgres implementation to be swapped for a mock in tests.
+type UserStore interface {
+	CreateUser(ctx context.Context, u User) (User, error)
+	ListUsers(ctx context.Context, params ListUsersParams) (ListUsersResult, error)
+	GetUser(ctx context.Context, id int64) (User, error)
+	UpdateUser(ctx context.Context, id int64, u User) (User, error)
+	DeleteUser(ctx context.Context, id int64) error
+
+	// CreateUserWithPassword registers a new user with a hashed password and
+	// a role, as used by the /auth/register flow.
+	CreateUserWithPassword(ctx context.Context, u User) (User, error)
+	// GetUserByEmail looks up a user including its password hash, for use
+	// by the login flow.
+	GetUserByEmail(ctx context.Context, email string) (User, error)
+}
+
+// PostgresUserStore is a UserStore backed by a pgx connection pool.
+type PostgresUserStore struct {
+	pool *pgxpool.Pool
+}
+
+// NewPostgresUserStore returns a UserStore backed by pool.
+func NewPostgresUserStore(pool *pgxpool.Pool) *PostgresUserStore {
+	return &PostgresUserStore{pool: pool}
+}
+
+func (s *PostgresUserStore) CreateUser(ctx context.Context, u User) (User, error) {
+	row := s.pool.QueryRow(ctx,
+		"INSERT INTO users (name, email) VALUES ($1, $2) RETURNING id, name, email",
+		u.Name, u.Email,
+	)
+
+	var created User
+	if err := row.Scan(&created.ID, &created.Name, &created.Email); err != nil {
+		return User{}, fmt.Errorf("inserting user: %w", err)
+	}
+	return created, nil
+}
+
+var userSortColumns = map[string]string{
+	"name":       "name",
+	"email":      "email",
+	"created_at": "created_at",
+}
+
+func (s *PostgresUserStore) ListUsers(ctx context.Context, params ListUsersParams) (ListUsersResult, error) {
+	sortColumn, ok := userSortColumns[params.Sort]
+	if !ok {
+		sortColumn = "created_at"
+	}
+	order := "ASC"
+	if strings.EqualFold(params.Order, "desc") {
+		order = "DESC"
+	}
+
+	where := ""
+	args := []any{}
+	if params.Search != "" {
+		where = "WHERE name ILIKE $1 OR email ILIKE $1"
+		args = append(args, "%"+params.Search+"%")
+	}
+
+	var total int
+	countQuery := "SELECT count(*) FROM users " + where
+	if err := s.pool.QueryRow(ctx, countQuery, args...).Scan(&total); err != nil {
+		return ListUsersResult{}, fmt.Errorf("counting users: %w", err)
+	}
+
+	query := fmt.Sprintf(
+		"SELECT id, name, email FROM users %s ORDER BY %s %s LIMIT $%d OFFSET $%d",
+		where, sortColumn, order, len(args)+1, len(args)+2,
+	)
+	args = append(args, params.Limit, params.Offset)
+
+	rows, err := s.pool.Query(ctx, query, args...)
+	if err != nil {
+		return ListUsersResult{}, fmt.Errorf("querying users: %w", err)
+	}
+	defer rows.Close()
+
+	var users []User
+	for rows.Next() {
+		var u User
+		if err := rows.Scan(&u.ID, &u.Name, &u.Email); err != nil {
+			return ListUsersResult{}, fmt.Errorf("scanning user: %w", err)
+		}
+		users = append(users, u)
+	}
+	if err := rows.Err(); err != nil {
+		return ListUsersResult{}, fmt.Errorf("reading users: %w", err)
+	}
+
+	return ListUsersResult{Users: users, Total: total}, nil
+}
+
+func (s *PostgresUserStore) GetUser(ctx context.Context, id int64) (User, error) {
+	row := s.pool.QueryRow(ctx, "SELECT id, name, email, role FROM users WHERE id = $1", id)
+
+	var u User
+	if err := row.Scan(&u.ID, &u.Name, &u.Email, &u.Role); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return User{}, ErrNotFound
+		}
+		return User{}, fmt.Errorf("scanning user: %w", err)
+	}
+	return u, nil
+}
+
+func (s *PostgresUserStore) UpdateUser(ctx context.Context, id int64, u User) (User, error) {
+	row := s.pool.QueryRow(ctx,
+		"UPDATE users SET name = $1, email = $2, updated_at = now() WHERE id = $3 RETURNING id, name, email",
+		u.Name, u.Email, id,
+	)
+
+	var updated User
+	if err := row.Scan(&updated.ID, &updated.Name, &updated.Email); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return User{}, ErrNotFound
+		}
+		return User{}, fmt.Errorf("updating user: %w", err)
+	}
+	return updated, nil
+}
+
+func (s *PostgresUserStore) DeleteUser(ctx context.Context, id int64) error {
+	tag, err := s.pool.Exec(ctx, "DELETE FROM users WHERE id = $1", id)
+	if err != nil {
+		return fmt.Errorf("deleting user: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+func (s *PostgresUserStore) CreateUserWithPassword(ctx context.Context, u User) (User, error) {
+	row := s.pool.QueryRow(ctx,
+		"INSERT INTO users (name, email, password_hash, role) VALUES ($1, $2, $3, $4) RETURNING id",
+		u.Name, u.Email, u.PasswordHash, u.Role,
+	)
+	if err := row.Scan(&u.ID); err != nil {
+		return User{}, fmt.Errorf("inserting user: %w", err)
+	}
+	return u, nil
+}
+
+func (s *PostgresUserStore) GetUserByEmail(ctx context.Context, email string) (User, error) {
+	row := s.pool.QueryRow(ctx,
+		"SELECT id, name, email, role, password_hash FROM users WHERE email = $1", email,
+	)
+
+	var u User
+	if err := row.Scan(&u.ID, &u.Name, &u.Email, &u.Role, &u.PasswordHash); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return User{}, ErrNotFound
+		}
+		return User{}, fmt.Errorf("scanning user: %w", err)
+	}
+	return u, nil
+}
@@ -0,0 +1,38 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// HealthServer implements the liveness and readiness probes.
+type HealthServer struct {
+	Pool *pgxpool.Pool
+}
+
+// NewHealthServer returns a HealthServer backed by pool.
+func NewHealthServer(pool *pgxpool.Pool) *HealthServer {
+	return &HealthServer{Pool: pool}
+}
+
+// Liveness always returns 200; it only confirms the process is running.
+func (h *HealthServer) Liveness(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusOK)
+}
+
+// Readiness pings the database with a short timeout and reports 503 if it
+// doesn't respond in time.
+func (h *HealthServer) Readiness(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), 2*time.Second)
+	defer cancel()
+
+	if err := h.Pool.Ping(ctx); err != nil {
+		http.Error(w, "Not ready: "+err.Error(), http.StatusServiceUnavailable)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
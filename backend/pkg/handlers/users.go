@@ -0,0 +1,248 @@
+// Package handlers implements the HTTP handlers for user CRUD operations.
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/AMJIETIK/k8s-user-platform/backend/pkg/db"
+	"github.com/AMJIETIK/k8s-user-platform/backend/pkg/metrics"
+	"github.com/AMJIETIK/k8s-user-platform/backend/pkg/validate"
+)
+
+// writeValidationError writes a structured 400 response for a failed
+// validate.Struct call.
+func writeValidationError(w http.ResponseWriter, fields map[string]string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusBadRequest)
+	_ = json.NewEncoder(w).Encode(map[string]any{
+		"error":  "validation failed",
+		"fields": fields,
+	})
+}
+
+const (
+	defaultListLimit = 20
+	maxListLimit     = 100
+)
+
+// userListEnvelope is the JSON response shape for GET /users.
+type userListEnvelope struct {
+	Data       []db.User `json:"data"`
+	NextCursor string    `json:"next_cursor"`
+	Total      int       `json:"total"`
+}
+
+// Server holds the dependencies user handlers need, in place of package
+// globals.
+type Server struct {
+	Store db.UserStore
+}
+
+// NewServer returns a handlers.Server backed by store.
+func NewServer(store db.UserStore) *Server {
+	return &Server{Store: store}
+}
+
+func (s *Server) CreateUser(w http.ResponseWriter, r *http.Request) {
+	var input db.User
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		http.Error(w, "Invalid JSON", http.StatusBadRequest)
+		return
+	}
+
+	if fields := validate.Struct(input); fields != nil {
+		writeValidationError(w, fields)
+		return
+	}
+
+	created, err := s.Store.CreateUser(r.Context(), input)
+	if err != nil {
+		http.Error(w, "Database error: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	metrics.UsersCreatedTotal.Inc()
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	_ = json.NewEncoder(w).Encode(created)
+}
+
+func (s *Server) ListUsers(w http.ResponseWriter, r *http.Request) {
+	params, err := parseListUsersParams(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	result, err := s.Store.ListUsers(r.Context(), params)
+	if err != nil {
+		http.Error(w, "Database error: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	envelope := userListEnvelope{Data: result.Users, Total: result.Total}
+	if params.Offset+len(result.Users) < result.Total {
+		envelope.NextCursor = strconv.Itoa(params.Offset + params.Limit)
+	}
+
+	setPaginationLinkHeader(w, r, params, result.Total)
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(envelope)
+}
+
+func parseListUsersParams(r *http.Request) (db.ListUsersParams, error) {
+	q := r.URL.Query()
+
+	limit := defaultListLimit
+	if raw := q.Get("limit"); raw != "" {
+		parsed, err := strconv.Atoi(raw)
+		if err != nil || parsed < 1 {
+			return db.ListUsersParams{}, errors.New("limit must be a positive integer")
+		}
+		limit = parsed
+	}
+	if limit > maxListLimit {
+		limit = maxListLimit
+	}
+
+	offset := 0
+	if raw := q.Get("offset"); raw != "" {
+		parsed, err := strconv.Atoi(raw)
+		if err != nil || parsed < 0 {
+			return db.ListUsersParams{}, errors.New("offset must be a non-negative integer")
+		}
+		offset = parsed
+	}
+
+	return db.ListUsersParams{
+		Limit:  limit,
+		Offset: offset,
+		Sort:   q.Get("sort"),
+		Order:  q.Get("order"),
+		Search: q.Get("search"),
+	}, nil
+}
+
+// setPaginationLinkHeader sets an RFC 5988 Link header with next/prev
+// navigation relative to the current request.
+func setPaginationLinkHeader(w http.ResponseWriter, r *http.Request, params db.ListUsersParams, total int) {
+	var links []string
+
+	if params.Offset+params.Limit < total {
+		links = append(links, fmt.Sprintf(`<%s>; rel="next"`, paginationURL(r, params.Offset+params.Limit, params.Limit)))
+	}
+	if params.Offset > 0 {
+		prevOffset := params.Offset - params.Limit
+		if prevOffset < 0 {
+			prevOffset = 0
+		}
+		links = append(links, fmt.Sprintf(`<%s>; rel="prev"`, paginationURL(r, prevOffset, params.Limit)))
+	}
+
+	if len(links) > 0 {
+		w.Header().Set("Link", links[0])
+		for _, link := range links[1:] {
+			w.Header().Add("Link", link)
+		}
+	}
+}
+
+func paginationURL(r *http.Request, offset, limit int) string {
+	q := r.URL.Query()
+	q.Set("offset", strconv.Itoa(offset))
+	q.Set("limit", strconv.Itoa(limit))
+
+	u := *r.URL
+	u.RawQuery = q.Encode()
+	return u.String()
+}
+
+func (s *Server) GetUser(w http.ResponseWriter, r *http.Request) {
+	id, err := userIDFromRequest(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	user, err := s.Store.GetUser(r.Context(), id)
+	if err != nil {
+		if errors.Is(err, db.ErrNotFound) {
+			http.Error(w, "User not found", http.StatusNotFound)
+			return
+		}
+		http.Error(w, "Database error: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(user)
+}
+
+func (s *Server) DeleteUser(w http.ResponseWriter, r *http.Request) {
+	id, err := userIDFromRequest(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if err := s.Store.DeleteUser(r.Context(), id); err != nil {
+		if errors.Is(err, db.ErrNotFound) {
+			http.Error(w, "User not found", http.StatusNotFound)
+			return
+		}
+		http.Error(w, "Database error: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	metrics.UsersDeletedTotal.Inc()
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) UpdateUser(w http.ResponseWriter, r *http.Request) {
+	id, err := userIDFromRequest(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	var input db.User
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		http.Error(w, "Invalid JSON", http.StatusBadRequest)
+		return
+	}
+
+	if fields := validate.Struct(input); fields != nil {
+		writeValidationError(w, fields)
+		return
+	}
+
+	updated, err := s.Store.UpdateUser(r.Context(), id, input)
+	if err != nil {
+		if errors.Is(err, db.ErrNotFound) {
+			http.Error(w, "User not found", http.StatusNotFound)
+			return
+		}
+		http.Error(w, "Database error: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(updated)
+}
+
+func userIDFromRequest(r *http.Request) (int64, error) {
+	id, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		return 0, errors.New("invalid user id")
+	}
+	return id, nil
+}
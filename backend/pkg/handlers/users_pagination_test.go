@@ -0,0 +1,78 @@
+package handlers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/AMJIETIK/k8s-user-platform/backend/pkg/db"
+)
+
+func TestParseListUsersParams(t *testing.T) {
+	tests := []struct {
+		name    string
+		query   string
+		want    db.ListUsersParams
+		wantErr bool
+	}{
+		{
+			name:  "defaults",
+			query: "",
+			want:  db.ListUsersParams{Limit: defaultListLimit, Offset: 0},
+		},
+		{
+			name:  "explicit values",
+			query: "limit=5&offset=10&sort=email&order=desc&search=ada",
+			want:  db.ListUsersParams{Limit: 5, Offset: 10, Sort: "email", Order: "desc", Search: "ada"},
+		},
+		{
+			name:  "limit above max is clamped",
+			query: "limit=1000",
+			want:  db.ListUsersParams{Limit: maxListLimit, Offset: 0},
+		},
+		{
+			name:    "invalid limit",
+			query:   "limit=abc",
+			wantErr: true,
+		},
+		{
+			name:    "negative offset",
+			query:   "offset=-1",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/users?"+tt.query, nil)
+			got, err := parseListUsersParams(req)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected an error, got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Fatalf("got %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPaginationURL(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/users?limit=10&offset=0&sort=name", nil)
+
+	got := paginationURL(req, 10, 10)
+
+	u, err := url.Parse(got)
+	if err != nil {
+		t.Fatalf("parsing result: %v", err)
+	}
+	if u.Query().Get("offset") != "10" || u.Query().Get("limit") != "10" || u.Query().Get("sort") != "name" {
+		t.Fatalf("unexpected pagination URL: %s", got)
+	}
+}
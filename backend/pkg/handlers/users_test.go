@@ -0,0 +1,157 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/AMJIETIK/k8s-user-platform/backend/pkg/db"
+)
+
+// mockUserStore is an in-memory db.UserStore for handler tests.
+type mockUserStore struct {
+	users  map[int64]db.User
+	nextID int64
+
+	listParams db.ListUsersParams
+	listResult db.ListUsersResult
+	listErr    error
+}
+
+func newMockUserStore() *mockUserStore {
+	return &mockUserStore{users: make(map[int64]db.User), nextID: 1}
+}
+
+func (m *mockUserStore) CreateUser(_ context.Context, u db.User) (db.User, error) {
+	u.ID = m.nextID
+	m.nextID++
+	m.users[u.ID] = u
+	return u, nil
+}
+
+func (m *mockUserStore) ListUsers(_ context.Context, params db.ListUsersParams) (db.ListUsersResult, error) {
+	m.listParams = params
+	if m.listErr != nil {
+		return db.ListUsersResult{}, m.listErr
+	}
+	return m.listResult, nil
+}
+
+func (m *mockUserStore) GetUser(_ context.Context, id int64) (db.User, error) {
+	u, ok := m.users[id]
+	if !ok {
+		return db.User{}, db.ErrNotFound
+	}
+	return u, nil
+}
+
+func (m *mockUserStore) UpdateUser(_ context.Context, id int64, u db.User) (db.User, error) {
+	if _, ok := m.users[id]; !ok {
+		return db.User{}, db.ErrNotFound
+	}
+	u.ID = id
+	m.users[id] = u
+	return u, nil
+}
+
+func (m *mockUserStore) DeleteUser(_ context.Context, id int64) error {
+	if _, ok := m.users[id]; !ok {
+		return db.ErrNotFound
+	}
+	delete(m.users, id)
+	return nil
+}
+
+func (m *mockUserStore) CreateUserWithPassword(ctx context.Context, u db.User) (db.User, error) {
+	return m.CreateUser(ctx, u)
+}
+
+func (m *mockUserStore) GetUserByEmail(_ context.Context, email string) (db.User, error) {
+	for _, u := range m.users {
+		if u.Email == email {
+			return u, nil
+		}
+	}
+	return db.User{}, db.ErrNotFound
+}
+
+func TestServer_CreateUser(t *testing.T) {
+	store := newMockUserStore()
+	s := NewServer(store)
+
+	body, _ := json.Marshal(db.User{Name: "Ada", Email: "ada@example.com"})
+	req := httptest.NewRequest(http.MethodPost, "/users", bytes.NewReader(body))
+	rec := httptest.NewRecorder()
+
+	s.CreateUser(rec, req)
+
+	if rec.Code != http.StatusCreated {
+		t.Fatalf("status = %d, want %d (body %s)", rec.Code, http.StatusCreated, rec.Body.String())
+	}
+
+	var created db.User
+	if err := json.Unmarshal(rec.Body.Bytes(), &created); err != nil {
+		t.Fatalf("decoding response: %v", err)
+	}
+	if created.ID == 0 || created.Name != "Ada" {
+		t.Fatalf("unexpected created user: %+v", created)
+	}
+}
+
+func TestServer_CreateUser_ValidationError(t *testing.T) {
+	store := newMockUserStore()
+	s := NewServer(store)
+
+	body, _ := json.Marshal(db.User{Name: "", Email: "not-an-email"})
+	req := httptest.NewRequest(http.MethodPost, "/users", bytes.NewReader(body))
+	rec := httptest.NewRecorder()
+
+	s.CreateUser(rec, req)
+
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusBadRequest)
+	}
+}
+
+func TestServer_GetUser_NotFound(t *testing.T) {
+	store := newMockUserStore()
+	s := NewServer(store)
+
+	req := httptest.NewRequest(http.MethodGet, "/users/42", nil)
+	rec := httptest.NewRecorder()
+	withURLParam(req, "id", "42")
+
+	s.GetUser(rec, req)
+
+	if rec.Code != http.StatusNotFound {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusNotFound)
+	}
+}
+
+func TestServer_GetUser_InvalidID(t *testing.T) {
+	store := newMockUserStore()
+	s := NewServer(store)
+
+	req := httptest.NewRequest(http.MethodGet, "/users/not-a-number", nil)
+	rec := httptest.NewRecorder()
+	withURLParam(req, "id", "not-a-number")
+
+	s.GetUser(rec, req)
+
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusBadRequest)
+	}
+}
+
+// withURLParam attaches a chi URL param to req's context, the same way the
+// chi router does when dispatching to a handler.
+func withURLParam(req *http.Request, key, value string) {
+	rctx := chi.NewRouteContext()
+	rctx.URLParams.Add(key, value)
+	*req = *req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rctx))
+}
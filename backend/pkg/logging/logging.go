@@ -0,0 +1,60 @@
+// Package logging provides structured JSON request logging via log/slog.
+package logging
+
+import (
+	"log/slog"
+	"net/http"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
+
+	"github.com/AMJIETIK/k8s-user-platform/backend/pkg/metrics"
+)
+
+// New returns a logger that writes structured JSON to stdout.
+func New() *slog.Logger {
+	return slog.New(slog.NewJSONHandler(os.Stdout, nil))
+}
+
+// Middleware logs each request as structured JSON, including the per-request
+// id set by chi's middleware.RequestID, and records HTTP metrics.
+func Middleware(logger *slog.Logger) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			start := time.Now()
+			ww := middleware.NewWrapResponseWriter(w, r.ProtoMajor)
+
+			next.ServeHTTP(ww, r)
+
+			duration := time.Since(start)
+			status := ww.Status()
+
+			// route is the matched route pattern, falling back to the raw path
+			// for logging, where an unbounded value per request is fine. metricsRoute is what's used as a Prometheus
+			// label: it falls back to a fixed "unmatched" instead of the raw
+			// path, since scanning arbitrary paths would otherwise create a
+			// new, unbounded label series per distinct path.
+			route := r.URL.Path
+			metricsRoute := "unmatched"
+			if rctx := chi.RouteContext(r.Context()); rctx != nil && rctx.RoutePattern() != "" {
+				route = rctx.RoutePattern()
+				metricsRoute = route
+			}
+
+			logger.InfoContext(r.Context(), "http_request",
+				"method", r.Method,
+				"route", route,
+				"status", status,
+				"duration_ms", duration.Milliseconds(),
+				"request_id", middleware.GetReqID(r.Context()),
+			)
+
+			labels := []string{r.Method, metricsRoute, strconv.Itoa(status)}
+			metrics.HTTPRequestsTotal.WithLabelValues(labels...).Inc()
+			metrics.HTTPRequestDuration.WithLabelValues(labels...).Observe(duration.Seconds())
+		})
+	}
+}
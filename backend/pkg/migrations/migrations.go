@@ -0,0 +1,72 @@
+// Package migrations embeds the platform's SQL migrations and applies them
+// with golang-migrate.
+package migrations
+
+import (
+	"embed"
+	"errors"
+	"fmt"
+
+	"github.com/golang-migrate/migrate/v4"
+	_ "github.com/golang-migrate/migrate/v4/database/postgres"
+	"github.com/golang-migrate/migrate/v4/source/iofs"
+)
+
+//go:embed sql/*.sql
+var sqlFS embed.FS
+
+func newMigrate(databaseURL string) (*migrate.Migrate, error) {
+	source, err := iofs.New(sqlFS, "sql")
+	if err != nil {
+		return nil, fmt.Errorf("loading embedded migrations: %w", err)
+	}
+
+	m, err := migrate.NewWithSourceInstance("iofs", source, databaseURL)
+	if err != nil {
+		return nil, fmt.Errorf("connecting migrate instance: %w", err)
+	}
+	return m, nil
+}
+
+// Up applies all pending migrations.
+func Up(databaseURL string) error {
+	m, err := newMigrate(databaseURL)
+	if err != nil {
+		return err
+	}
+	defer m.Close()
+
+	if err := m.Up(); err != nil && !errors.Is(err, migrate.ErrNoChange) {
+		return fmt.Errorf("applying migrations: %w", err)
+	}
+	return nil
+}
+
+// Down rolls back all applied migrations.
+func Down(databaseURL string) error {
+	m, err := newMigrate(databaseURL)
+	if err != nil {
+		return err
+	}
+	defer m.Close()
+
+	if err := m.Down(); err != nil && !errors.Is(err, migrate.ErrNoChange) {
+		return fmt.Errorf("rolling back migrations: %w", err)
+	}
+	return nil
+}
+
+// Version reports the currently applied migration version.
+func Version(databaseURL string) (version uint, dirty bool, err error) {
+	m, err := newMigrate(databaseURL)
+	if err != nil {
+		return 0, false, err
+	}
+	defer m.Close()
+
+	version, dirty, err = m.Version()
+	if err != nil && !errors.Is(err, migrate.ErrNilVersion) {
+		return 0, false, fmt.Errorf("reading migration version: %w", err)
+	}
+	return version, dirty, nil
+}
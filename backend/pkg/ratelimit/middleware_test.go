@@ -0,0 +1,59 @@
+package ratelimit
+
+import (
+	"net"
+	"net/http"
+	"testing"
+)
+
+func mustCIDR(t *testing.T, s string) *net.IPNet {
+	t.Helper()
+	_, network, err := net.ParseCIDR(s)
+	if err != nil {
+		t.Fatalf("ParseCIDR(%q): %v", s, err)
+	}
+	return network
+}
+
+func TestClientIP_UntrustedRemoteIgnoresForwardedFor(t *testing.T) {
+	r := &http.Request{RemoteAddr: "203.0.113.5:1234", Header: http.Header{
+		"X-Forwarded-For": []string{"198.51.100.9"},
+	}}
+
+	if got := clientIP(r, []*net.IPNet{mustCIDR(t, "10.0.0.0/8")}); got != "203.0.113.5" {
+		t.Fatalf("clientIP = %q, want RemoteAddr since it isn't a trusted proxy", got)
+	}
+}
+
+func TestClientIP_TrustedProxyUsesForwardedFor(t *testing.T) {
+	r := &http.Request{RemoteAddr: "10.0.0.1:1234", Header: http.Header{
+		"X-Forwarded-For": []string{"198.51.100.9"},
+	}}
+
+	trusted := []*net.IPNet{mustCIDR(t, "10.0.0.0/8")}
+	if got := clientIP(r, trusted); got != "198.51.100.9" {
+		t.Fatalf("clientIP = %q, want the forwarded client IP", got)
+	}
+}
+
+func TestClientIP_SkipsTrustedHopsInForwardedForChain(t *testing.T) {
+	r := &http.Request{RemoteAddr: "10.0.0.1:1234", Header: http.Header{
+		// Leftmost hop is the client, then two trusted proxies it passed through.
+		"X-Forwarded-For": []string{"198.51.100.9, 10.0.0.2, 10.0.0.3"},
+	}}
+
+	trusted := []*net.IPNet{mustCIDR(t, "10.0.0.0/8")}
+	if got := clientIP(r, trusted); got != "198.51.100.9" {
+		t.Fatalf("clientIP = %q, want the real client IP beyond the trusted hops", got)
+	}
+}
+
+func TestClientIP_NoTrustedProxiesConfiguredIgnoresForwardedFor(t *testing.T) {
+	r := &http.Request{RemoteAddr: "10.0.0.1:1234", Header: http.Header{
+		"X-Forwarded-For": []string{"198.51.100.9"},
+	}}
+
+	if got := clientIP(r, nil); got != "10.0.0.1" {
+		t.Fatalf("clientIP = %q, want RemoteAddr when no proxies are trusted", got)
+	}
+}
@@ -0,0 +1,95 @@
+// Package ratelimit implements a token-bucket rate limiter middleware, with
+// an in-memory store for single-replica deployments and a Redis-backed
+// store for multi-replica ones.
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// Config controls the allowed request rate.
+type Config struct {
+	RPS   float64
+	Burst int
+}
+
+// Limiter decides whether the request identified by key is allowed, and if
+// not, how long the caller should wait before retrying.
+type Limiter interface {
+	Allow(ctx context.Context, key string) (allowed bool, retryAfter time.Duration, err error)
+}
+
+const (
+	// bucketTTL is how long a key's bucket is kept after its last request
+	// before being evicted.
+	bucketTTL = 10 * time.Minute
+	// sweepInterval is how often idle buckets are swept.
+	sweepInterval = time.Minute
+)
+
+// bucket pairs a token bucket with the last time it was used, so idle
+// buckets can be evicted.
+type bucket struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+// memoryLimiter keeps one token bucket per key in memory, evicting buckets
+// that have been idle for longer than bucketTTL so the map doesn't grow
+// without bound as new keys (e.g. rotating client IPs) are seen.
+type memoryLimiter struct {
+	cfg     Config
+	mu      sync.Mutex
+	buckets map[string]*bucket
+}
+
+// NewMemoryLimiter returns a Limiter backed by in-process token buckets. It
+// starts a background goroutine that periodically evicts idle buckets for
+// the lifetime of the process.
+func NewMemoryLimiter(cfg Config) Limiter {
+	l := &memoryLimiter{cfg: cfg, buckets: make(map[string]*bucket)}
+	go l.sweepLoop()
+	return l
+}
+
+func (l *memoryLimiter) Allow(_ context.Context, key string) (bool, time.Duration, error) {
+	l.mu.Lock()
+	b, ok := l.buckets[key]
+	if !ok {
+		b = &bucket{limiter: rate.NewLimiter(rate.Limit(l.cfg.RPS), l.cfg.Burst)}
+		l.buckets[key] = b
+	}
+	b.lastSeen = time.Now()
+	limiter := b.limiter
+	l.mu.Unlock()
+
+	if limiter.Allow() {
+		return true, 0, nil
+	}
+	return false, time.Duration(float64(time.Second) / l.cfg.RPS), nil
+}
+
+// sweepLoop evicts idle buckets every sweepInterval until the process exits.
+func (l *memoryLimiter) sweepLoop() {
+	ticker := time.NewTicker(sweepInterval)
+	defer ticker.Stop()
+	for range ticker.C {
+		l.sweep()
+	}
+}
+
+func (l *memoryLimiter) sweep() {
+	cutoff := time.Now().Add(-bucketTTL)
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for key, b := range l.buckets {
+		if b.lastSeen.Before(cutoff) {
+			delete(l.buckets, key)
+		}
+	}
+}
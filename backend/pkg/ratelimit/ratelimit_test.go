@@ -0,0 +1,79 @@
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestMemoryLimiter_AllowsUpToBurstThenDenies(t *testing.T) {
+	limiter := NewMemoryLimiter(Config{RPS: 1, Burst: 3})
+	ctx := context.Background()
+
+	for i := 0; i < 3; i++ {
+		allowed, _, err := limiter.Allow(ctx, "client-a")
+		if err != nil {
+			t.Fatalf("Allow: %v", err)
+		}
+		if !allowed {
+			t.Fatalf("request %d: expected allowed, got denied", i)
+		}
+	}
+
+	allowed, retryAfter, err := limiter.Allow(ctx, "client-a")
+	if err != nil {
+		t.Fatalf("Allow: %v", err)
+	}
+	if allowed {
+		t.Fatal("expected the request beyond burst capacity to be denied")
+	}
+	if retryAfter <= 0 {
+		t.Fatalf("retryAfter = %v, want > 0", retryAfter)
+	}
+}
+
+func TestMemoryLimiter_TracksKeysIndependently(t *testing.T) {
+	limiter := NewMemoryLimiter(Config{RPS: 1, Burst: 1})
+	ctx := context.Background()
+
+	allowedA, _, err := limiter.Allow(ctx, "client-a")
+	if err != nil || !allowedA {
+		t.Fatalf("client-a: allowed=%v err=%v", allowedA, err)
+	}
+
+	allowedB, _, err := limiter.Allow(ctx, "client-b")
+	if err != nil || !allowedB {
+		t.Fatalf("client-b: allowed=%v err=%v", allowedB, err)
+	}
+
+	allowedA2, _, err := limiter.Allow(ctx, "client-a")
+	if err != nil {
+		t.Fatalf("Allow: %v", err)
+	}
+	if allowedA2 {
+		t.Fatal("expected client-a's second request within the same window to be denied")
+	}
+}
+
+func TestMemoryLimiter_SweepEvictsIdleBuckets(t *testing.T) {
+	l := NewMemoryLimiter(Config{RPS: 1, Burst: 1}).(*memoryLimiter)
+	ctx := context.Background()
+
+	if _, _, err := l.Allow(ctx, "client-a"); err != nil {
+		t.Fatalf("Allow: %v", err)
+	}
+
+	l.mu.Lock()
+	l.buckets["client-a"].lastSeen = time.Now().Add(-bucketTTL - time.Second)
+	l.mu.Unlock()
+
+	l.sweep()
+
+	l.mu.Lock()
+	_, stillPresent := l.buckets["client-a"]
+	l.mu.Unlock()
+
+	if stillPresent {
+		t.Fatal("expected idle bucket to be evicted")
+	}
+}
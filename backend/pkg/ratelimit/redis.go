@@ -0,0 +1,88 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// tokenBucketScript implements a token bucket in Redis: tokens refill
+// continuously at ARGV[1] (rate) per second, capped at ARGV[2] (burst
+// capacity). State lives in a hash so it's shared across replicas and
+// survives between invocations. Returns {allowed, tokens_remaining}.
+var tokenBucketScript = redis.NewScript(`
+local key = KEYS[1]
+local rate = tonumber(ARGV[1])
+local burst = tonumber(ARGV[2])
+local now = tonumber(ARGV[3])
+local ttl = tonumber(ARGV[4])
+
+local bucket = redis.call('HMGET', key, 'tokens', 'updated_at')
+local tokens = tonumber(bucket[1])
+local updatedAt = tonumber(bucket[2])
+if tokens == nil then
+	tokens = burst
+	updatedAt = now
+end
+
+local elapsed = math.max(0, now - updatedAt)
+tokens = math.min(burst, tokens + elapsed * rate)
+
+local allowed = 0
+if tokens >= 1 then
+	allowed = 1
+	tokens = tokens - 1
+end
+
+redis.call('HMSET', key, 'tokens', tokens, 'updated_at', now)
+redis.call('EXPIRE', key, ttl)
+
+return { allowed, tostring(tokens) }
+`)
+
+// redisLimiter rate-limits across replicas using a Redis-backed token
+// bucket: tokens refill at cfg.RPS per second up to a cfg.Burst cap, the
+// same semantics as the in-memory limiter's rate.Limiter.
+type redisLimiter struct {
+	client *redis.Client
+	cfg    Config
+}
+
+// NewRedisLimiter returns a Limiter backed by client, for use across
+// multiple replicas.
+func NewRedisLimiter(client *redis.Client, cfg Config) Limiter {
+	return &redisLimiter{client: client, cfg: cfg}
+}
+
+func (l *redisLimiter) Allow(ctx context.Context, key string) (bool, time.Duration, error) {
+	bucketKey := fmt.Sprintf("ratelimit:%s", key)
+	now := float64(time.Now().UnixNano()) / float64(time.Second)
+
+	// A bucket idle long enough to fully refill from empty no longer needs
+	// to be kept around; expire it instead of growing Redis memory forever.
+	ttlSeconds := int(float64(l.cfg.Burst)/l.cfg.RPS) + 1
+	if ttlSeconds < 1 {
+		ttlSeconds = 1
+	}
+
+	res, err := tokenBucketScript.Run(ctx, l.client, []string{bucketKey}, l.cfg.RPS, l.cfg.Burst, now, ttlSeconds).Result()
+	if err != nil {
+		return false, 0, fmt.Errorf("evaluating rate limit script: %w", err)
+	}
+
+	vals, ok := res.([]interface{})
+	if !ok || len(vals) != 2 {
+		return false, 0, fmt.Errorf("unexpected rate limit script result: %v", res)
+	}
+	allowed, ok := vals[0].(int64)
+	if !ok {
+		return false, 0, fmt.Errorf("unexpected rate limit script result: %v", res)
+	}
+
+	if allowed == 1 {
+		return true, 0, nil
+	}
+	return false, time.Duration(float64(time.Second) / l.cfg.RPS), nil
+}
@@ -0,0 +1,115 @@
+// Package server wires the HTTP router together and runs it with graceful
+// shutdown.
+package server
+
+import (
+	"context"
+	"log/slog"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/go-chi/cors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+
+	"github.com/AMJIETIK/k8s-user-platform/backend/pkg/auth"
+	"github.com/AMJIETIK/k8s-user-platform/backend/pkg/handlers"
+	"github.com/AMJIETIK/k8s-user-platform/backend/pkg/logging"
+	"github.com/AMJIETIK/k8s-user-platform/backend/pkg/ratelimit"
+)
+
+// Server runs the platform HTTP API.
+type Server struct {
+	httpServer *http.Server
+	logger     *slog.Logger
+}
+
+// New builds a Server listening on addr, routing /api/v1 to h, /auth to a,
+// /healthz and /readyz to health, and /metrics to the Prometheus handler. Mutating /api/v1/users routes
+// require a valid token: POST is admin-only (real account creation goes
+// through /auth/register), and DELETE/PUT are further restricted to the
+// resource owner or an admin. trustedProxies are the networks the rate
+// limiter trusts to set X-Forwarded-For; pass nil if the service isn't
+// behind a proxy.
+func New(addr string, h *handlers.Server, a *handlers.AuthServer, authSvc *auth.Service, health *handlers.HealthServer, logger *slog.Logger, limiter ratelimit.Limiter, trustedProxies []*net.IPNet) *Server {
+	r := chi.NewRouter()
+	r.Use(middleware.RequestID)
+	r.Use(middleware.Recoverer)
+	r.Use(logging.Middleware(logger))
+	r.Use(cors.Handler(cors.Options{
+		AllowedOrigins: []string{"*"},
+		AllowedMethods: []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"},
+		AllowedHeaders: []string{"Content-Type", "Authorization"},
+	}))
+	// Registered before the rate limiter below so kubelet probes and
+	// Prometheus scrapes are exempt from it: these are operator/infra
+	// endpoints, not public API surface, and a throttled liveness probe
+	// gets the pod killed instead of just slowed down.
+	r.Get("/healthz", health.Liveness)
+	r.Get("/readyz", health.Readiness)
+	r.Handle("/metrics", promhttp.Handler())
+
+	r.Use(ratelimit.IPMiddleware(limiter, trustedProxies))
+
+	r.Route("/auth", func(r chi.Router) {
+		r.Post("/register", a.Register)
+		r.Post("/login", a.Login)
+		r.Post("/refresh", a.Refresh)
+		r.With(authSvc.Middleware).Post("/logout", a.Logout)
+	})
+
+	r.Route("/api/v1", func(r chi.Router) {
+		r.Route("/users", func(r chi.Router) {
+			r.Get("/", h.ListUsers)
+			r.Get("/{id}", h.GetUser)
+
+			r.Group(func(r chi.Router) {
+				r.Use(authSvc.Middleware)
+				r.Use(ratelimit.UserMiddleware(limiter, trustedProxies))
+				r.With(auth.RequireAdmin).Post("/", h.CreateUser)
+				r.With(auth.RequireSelfOrAdmin).Put("/{id}", h.UpdateUser)
+				r.With(auth.RequireSelfOrAdmin).Delete("/{id}", h.DeleteUser)
+			})
+		})
+	})
+
+	return &Server{
+		httpServer: &http.Server{
+			Addr:    addr,
+			Handler: r,
+		},
+		logger: logger,
+	}
+}
+
+// Run starts the server and blocks until ctx is cancelled, at which point it
+// shuts down gracefully.
+func (s *Server) Run(ctx context.Context) error {
+	errCh := make(chan error, 1)
+	go func() {
+		s.logger.Info("server listening", "addr", s.httpServer.Addr)
+		if err := s.httpServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			errCh <- err
+		}
+	}()
+
+	select {
+	case err := <-errCh:
+		return err
+	case <-ctx.Done():
+	}
+
+	s.logger.Info("shutting down server")
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if err := s.httpServer.Shutdown(shutdownCtx); err != nil {
+		return err
+	}
+
+	s.logger.Info("server stopped")
+	return nil
+}
@@ -0,0 +1,57 @@
+// Package validate wraps go-playground/validator to turn struct validation
+// failures into a field-name-to-message map suitable for API responses.
+package validate
+
+import (
+	"fmt"
+	"unicode"
+
+	"github.com/go-playground/validator/v10"
+)
+
+var v = newValidator()
+
+func newValidator() *validator.Validate {
+	v := validator.New()
+	_ = v.RegisterValidation("nocontrol", func(fl validator.FieldLevel) bool {
+		for _, r := range fl.Field().String() {
+			if unicode.IsControl(r) {
+				return false
+			}
+		}
+		return true
+	})
+	return v
+}
+
+// Struct validates s and returns a map of field name to human-readable
+// error message, or nil if s is valid.
+func Struct(s any) map[string]string {
+	err := v.Struct(s)
+	if err == nil {
+		return nil
+	}
+
+	fields := make(map[string]string)
+	for _, fe := range err.(validator.ValidationErrors) {
+		fields[fe.Field()] = message(fe)
+	}
+	return fields
+}
+
+func message(fe validator.FieldError) string {
+	switch fe.Tag() {
+	case "required":
+		return "is required"
+	case "email":
+		return "must be a valid email address"
+	case "min":
+		return fmt.Sprintf("must be at least %s characters", fe.Param())
+	case "max":
+		return fmt.Sprintf("must be at most %s characters", fe.Param())
+	case "nocontrol":
+		return "must not contain control characters"
+	default:
+		return "is invalid"
+	}
+}
@@ -0,0 +1,48 @@
+package validate
+
+import "testing"
+
+func TestStruct(t *testing.T) {
+	type input struct {
+		Name  string `validate:"required,min=1,max=5,nocontrol"`
+		Email string `validate:"required,email"`
+	}
+
+	t.Run("valid", func(t *testing.T) {
+		fields := Struct(input{Name: "Ada", Email: "ada@example.com"})
+		if fields != nil {
+			t.Fatalf("expected no errors, got %v", fields)
+		}
+	})
+
+	t.Run("missing required fields", func(t *testing.T) {
+		fields := Struct(input{})
+		if fields["Name"] != "is required" {
+			t.Fatalf("Name = %q, want %q", fields["Name"], "is required")
+		}
+		if fields["Email"] != "is required" {
+			t.Fatalf("Email = %q, want %q", fields["Email"], "is required")
+		}
+	})
+
+	t.Run("invalid email", func(t *testing.T) {
+		fields := Struct(input{Name: "Ada", Email: "not-an-email"})
+		if fields["Email"] != "must be a valid email address" {
+			t.Fatalf("Email = %q, want %q", fields["Email"], "must be a valid email address")
+		}
+	})
+
+	t.Run("exceeds max length", func(t *testing.T) {
+		fields := Struct(input{Name: "too-long", Email: "ada@example.com"})
+		if fields["Name"] != "must be at most 5 characters" {
+			t.Fatalf("Name = %q, want %q", fields["Name"], "must be at most 5 characters")
+		}
+	})
+
+	t.Run("control characters rejected", func(t *testing.T) {
+		fields := Struct(input{Name: "a\x00b", Email: "ada@example.com"})
+		if fields["Name"] != "must not contain control characters" {
+			t.Fatalf("Name = %q, want %q", fields["Name"], "must not contain control characters")
+		}
+	})
+}